@@ -0,0 +1,52 @@
+// Copyright 2020 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collector
+
+import (
+	"fmt"
+	"regexp"
+)
+
+type deviceFilter struct {
+	ignoredPattern  *regexp.Regexp
+	acceptedPattern *regexp.Regexp
+}
+
+func newDeviceFilter(ignoredDevicesPattern, acceptedDevicesPattern string) (deviceFilter, error) {
+	var f deviceFilter
+
+	if ignoredDevicesPattern != "" {
+		pattern, err := regexp.Compile(ignoredDevicesPattern)
+		if err != nil {
+			return f, fmt.Errorf("failed to compile device-exclude regexp: %w", err)
+		}
+		f.ignoredPattern = pattern
+	}
+
+	if acceptedDevicesPattern != "" {
+		pattern, err := regexp.Compile(acceptedDevicesPattern)
+		if err != nil {
+			return f, fmt.Errorf("failed to compile device-include regexp: %w", err)
+		}
+		f.acceptedPattern = pattern
+	}
+
+	return f, nil
+}
+
+// ignored returns whether the device should be ignored.
+func (f *deviceFilter) ignored(name string) bool {
+	return f.ignoredPattern != nil && f.ignoredPattern.MatchString(name) ||
+		f.acceptedPattern != nil && !f.acceptedPattern.MatchString(name)
+}
@@ -0,0 +1,61 @@
+// Copyright 2020 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collector
+
+import "testing"
+
+func TestDeviceFilterIgnored(t *testing.T) {
+	f, err := newDeviceFilter("^pass", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !f.ignored("pass0") {
+		t.Error("pass0 should be ignored")
+	}
+	if f.ignored("ada0") {
+		t.Error("ada0 should not be ignored")
+	}
+}
+
+func TestDeviceFilterAccepted(t *testing.T) {
+	f, err := newDeviceFilter("", "^ada")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f.ignored("ada0") {
+		t.Error("ada0 should be accepted")
+	}
+	if !f.ignored("cd0") {
+		t.Error("cd0 should be ignored, it does not match the accepted pattern")
+	}
+}
+
+func TestDeviceFilterEmpty(t *testing.T) {
+	f, err := newDeviceFilter("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f.ignored("ada0") {
+		t.Error("ada0 should not be ignored when no patterns are set")
+	}
+}
+
+func TestDeviceFilterInvalidPattern(t *testing.T) {
+	if _, err := newDeviceFilter("[", ""); err == nil {
+		t.Error("expected an error for an invalid device-exclude regexp")
+	}
+	if _, err := newDeviceFilter("", "["); err == nil {
+		t.Error("expected an error for an invalid device-include regexp")
+	}
+}
@@ -24,6 +24,7 @@ import (
 
 	"github.com/go-kit/log"
 	"github.com/prometheus/client_golang/prometheus"
+	kingpin "gopkg.in/alecthomas/kingpin.v2"
 )
 
 // #cgo LDFLAGS: -ldevstat -lkvm -lelf
@@ -32,16 +33,24 @@ import "C"
 
 const (
 	devstatSubsystem = "devstat"
+	// devstatSectorSize is the sector size, in bytes, used to convert the
+	// byte counters devstat reports into the sector counts node_disk_* uses.
+	devstatSectorSize = 512
+)
+
+var (
+	devstatDeviceExclude = kingpin.Flag("collector.devstat.device-exclude", "Regexp of devices to exclude for devstat.").String()
+	devstatDeviceInclude = kingpin.Flag("collector.devstat.device-include", "Regexp of devices to include for devstat.").String()
 )
 
 type devstatCollector struct {
-	mu      sync.Mutex
-	devinfo *C.struct_devinfo
+	mu           sync.Mutex
+	devinfo      *C.struct_devinfo
+	deviceFilter deviceFilter
 
 	bytes        typedDesc
 	transfers    typedDesc
 	duration     typedDesc
-	busyTime     typedDesc
 	busy_percent typedDesc
 	blocks       typedDesc
 	tps          typedDesc
@@ -58,8 +67,18 @@ func init() {
 
 // NewDevstatCollector returns a new Collector exposing Device stats.
 func NewDevstatCollector(logger log.Logger) (Collector, error) {
+	if *devstatDeviceExclude != "" && *devstatDeviceInclude != "" {
+		return nil, errors.New("collector.devstat.device-exclude and collector.devstat.device-include are mutually exclusive")
+	}
+
+	filter, err := newDeviceFilter(*devstatDeviceExclude, *devstatDeviceInclude)
+	if err != nil {
+		return nil, err
+	}
+
 	return &devstatCollector{
-		devinfo: &C.struct_devinfo{},
+		devinfo:      &C.struct_devinfo{},
+		deviceFilter: filter,
 		bytes: typedDesc{prometheus.NewDesc(
 			prometheus.BuildFQName(namespace, devstatSubsystem, "bytes_total"),
 			"The total number of bytes in transactions.",
@@ -75,11 +94,6 @@ func NewDevstatCollector(logger log.Logger) (Collector, error) {
 			"The total duration of transactions in seconds.",
 			[]string{"device", "type"}, nil,
 		), prometheus.CounterValue},
-		busyTime: typedDesc{prometheus.NewDesc(
-			prometheus.BuildFQName(namespace, devstatSubsystem, "busy_time_seconds_total"),
-			"Total time the device had one or more transactions outstanding in seconds.",
-			[]string{"device"}, nil,
-		), prometheus.CounterValue},
 		busy_percent: typedDesc{prometheus.NewDesc(
 			prometheus.BuildFQName(namespace, devstatSubsystem, "busy_time_percentage_total"),
 			"Total percentage of the block device time spent in busy.",
@@ -135,16 +149,30 @@ func (c *devstatCollector) Update(ch chan<- prometheus.Metric) error {
 		stat := (*C.Stats)(unsafe.Pointer(uintptr(base) + uintptr(offset)))
 
 		device := fmt.Sprintf("%s%d", C.GoString(&stat.device[0]), stat.unit)
-		ch <- c.bytes.mustNewConstMetric(float64(stat.bytes.read), device, "read")
-		ch <- c.bytes.mustNewConstMetric(float64(stat.bytes.write), device, "write")
+		if c.deviceFilter.ignored(device) {
+			continue
+		}
+		ch <- c.bytes.mustNewConstMetric(float64(stat.bytes.free), device, "free")
+		ch <- c.bytes.mustNewConstMetric(float64(stat.bytes.other), device, "other")
 		ch <- c.transfers.mustNewConstMetric(float64(stat.transfers.other), device, "other")
-		ch <- c.transfers.mustNewConstMetric(float64(stat.transfers.read), device, "read")
-		ch <- c.transfers.mustNewConstMetric(float64(stat.transfers.write), device, "write")
+		ch <- c.transfers.mustNewConstMetric(float64(stat.transfers.free), device, "free")
 		ch <- c.duration.mustNewConstMetric(float64(stat.duration.other), device, "other")
-		ch <- c.duration.mustNewConstMetric(float64(stat.duration.read), device, "read")
-		ch <- c.duration.mustNewConstMetric(float64(stat.duration.write), device, "write")
-		ch <- c.busyTime.mustNewConstMetric(float64(stat.busy_time), device)
+		ch <- c.duration.mustNewConstMetric(float64(stat.duration.free), device, "free")
 		ch <- c.blocks.mustNewConstMetric(float64(stat.blocks), device)
+		// read/write bytes, transfers and durations, and busy time, are
+		// reported under node_disk_* instead.
+		ch <- readBytesDesc.mustNewConstMetric(float64(stat.bytes.read), device)
+		ch <- writtenBytesDesc.mustNewConstMetric(float64(stat.bytes.write), device)
+		ch <- readsCompletedDesc.mustNewConstMetric(float64(stat.transfers.read), device)
+		ch <- writesCompletedDesc.mustNewConstMetric(float64(stat.transfers.write), device)
+		ch <- readTimeSecondsDesc.mustNewConstMetric(float64(stat.duration.read), device)
+		ch <- writeTimeSecondsDesc.mustNewConstMetric(float64(stat.duration.write), device)
+		ch <- ioTimeSecondsDesc.mustNewConstMetric(float64(stat.busy_time), device)
+		// FreeBSD's devstat reports BIO_DELETE/TRIM activity under the
+		// "free" transaction type; surface it under the same discard metric
+		// names Linux exposes so ZFS/UFS TRIM activity is visible too.
+		ch <- discardsCompletedDesc.mustNewConstMetric(float64(stat.transfers.free), device)
+		ch <- discardedSectorsDesc.mustNewConstMetric(float64(stat.bytes.free)/devstatSectorSize, device)
 		ch <- c.busy_percent.mustNewConstMetric(float64(stat.busy_percent), device)
 		ch <- c.queue_length.mustNewConstMetric(float64(stat.queue_length), device)
 		ch <- c.tps.mustNewConstMetric(float64(stat.tps.read), device, "read")
@@ -154,6 +182,8 @@ func (c *devstatCollector) Update(ch chan<- prometheus.Metric) error {
 		ch <- c.tps.mustNewConstMetric(float64(stat.tps.total), device, "total")
 		ch <- c.mbps.mustNewConstMetric(float64(stat.mbps.read), device, "read")
 		ch <- c.mbps.mustNewConstMetric(float64(stat.mbps.write), device, "write")
+		ch <- c.mbps.mustNewConstMetric(float64(stat.mbps.free), device, "free")
+		ch <- c.mbps.mustNewConstMetric(float64(stat.mbps.other), device, "other")
 		ch <- c.kbpt.mustNewConstMetric(float64(stat.kbpt.read), device, "read")
 		ch <- c.kbpt.mustNewConstMetric(float64(stat.kbpt.write), device, "write")
 		ch <- c.kbpt.mustNewConstMetric(float64(stat.kbpt.free), device, "free")
@@ -0,0 +1,79 @@
+// Copyright 2017 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collector
+
+import "github.com/prometheus/client_golang/prometheus"
+
+// Metric descriptors for the common node_disk_* names, so that any
+// per-platform diskstats/devstat collector that chooses to report through
+// them exposes the same metric names regardless of OS.
+const (
+	diskSubsystem = "disk"
+)
+
+var (
+	readsCompletedDesc = typedDesc{prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, diskSubsystem, "reads_completed_total"),
+		"The total number of reads completed successfully.",
+		[]string{"device"}, nil,
+	), prometheus.CounterValue}
+
+	readBytesDesc = typedDesc{prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, diskSubsystem, "read_bytes_total"),
+		"The total number of bytes read successfully.",
+		[]string{"device"}, nil,
+	), prometheus.CounterValue}
+
+	writesCompletedDesc = typedDesc{prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, diskSubsystem, "writes_completed_total"),
+		"The total number of writes completed successfully.",
+		[]string{"device"}, nil,
+	), prometheus.CounterValue}
+
+	writtenBytesDesc = typedDesc{prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, diskSubsystem, "written_bytes_total"),
+		"The total number of bytes written successfully.",
+		[]string{"device"}, nil,
+	), prometheus.CounterValue}
+
+	ioTimeSecondsDesc = typedDesc{prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, diskSubsystem, "io_time_seconds_total"),
+		"Total seconds spent doing I/Os.",
+		[]string{"device"}, nil,
+	), prometheus.CounterValue}
+
+	readTimeSecondsDesc = typedDesc{prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, diskSubsystem, "read_time_seconds_total"),
+		"The total number of seconds spent by all reads.",
+		[]string{"device"}, nil,
+	), prometheus.CounterValue}
+
+	writeTimeSecondsDesc = typedDesc{prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, diskSubsystem, "write_time_seconds_total"),
+		"The total number of seconds spent by all writes.",
+		[]string{"device"}, nil,
+	), prometheus.CounterValue}
+
+	discardsCompletedDesc = typedDesc{prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, diskSubsystem, "discards_completed_total"),
+		"The total number of discards completed successfully.",
+		[]string{"device"}, nil,
+	), prometheus.CounterValue}
+
+	discardedSectorsDesc = typedDesc{prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, diskSubsystem, "discarded_sectors_total"),
+		"The total number of sectors discarded successfully.",
+		[]string{"device"}, nil,
+	), prometheus.CounterValue}
+)
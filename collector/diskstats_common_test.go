@@ -0,0 +1,49 @@
+// Copyright 2017 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collector
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestDiskstatsCommonDescs checks that every shared node_disk_* descriptor
+// is named as expected, so a platform collector wiring one up
+// can rely on the name without re-deriving it. The devstat_freebsd.go
+// collector that consumes these is cgo/FreeBSD-gated and can't be exercised
+// directly in this (non-FreeBSD) test environment.
+func TestDiskstatsCommonDescs(t *testing.T) {
+	cases := []struct {
+		desc typedDesc
+		name string
+	}{
+		{readsCompletedDesc, "reads_completed_total"},
+		{readBytesDesc, "read_bytes_total"},
+		{writesCompletedDesc, "writes_completed_total"},
+		{writtenBytesDesc, "written_bytes_total"},
+		{readTimeSecondsDesc, "read_time_seconds_total"},
+		{writeTimeSecondsDesc, "write_time_seconds_total"},
+		{ioTimeSecondsDesc, "io_time_seconds_total"},
+		{discardsCompletedDesc, "discards_completed_total"},
+		{discardedSectorsDesc, "discarded_sectors_total"},
+	}
+
+	for _, c := range cases {
+		got := c.desc.desc.String()
+		want := "node_" + diskSubsystem + "_" + c.name
+		if !strings.Contains(got, want) {
+			t.Errorf("descriptor for %q does not contain expected FQName %q: %s", c.name, want, got)
+		}
+	}
+}